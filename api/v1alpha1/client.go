@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+)
+
+// scheme is a private Scheme that only knows about this package's types, used to
+// build the REST codec for Client. Callers that also need the built-in types (e.g.
+// to share a scheme with an informer factory) should AddToScheme(theirScheme) instead
+// of reusing this one.
+var scheme = runtime.NewScheme()
+
+// ParameterCodec encodes/decodes list and get options against scheme, the same way
+// client-gen generated clientsets wire their "scheme" subpackage.
+var ParameterCodec = runtime.NewParameterCodec(scheme)
+
+func init() {
+	if err := AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+}
+
+// Client is a minimal typed client for the secret-operator.example.com/v1alpha1 API.
+// It follows the same List/Watch/Get/UpdateStatus shape client-gen would produce,
+// hand-written here since this repo has no code-generation toolchain wired up yet.
+type Client struct {
+	restClient rest.Interface
+}
+
+// NewForConfig builds a Client from a rest.Config, mirroring kubernetes.NewForConfig.
+func NewForConfig(c *rest.Config) (*Client, error) {
+	config := *c
+	config.GroupVersion = &GroupVersion
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = serializer.NewCodecFactory(scheme).WithoutConversion()
+
+	restClient, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{restClient: restClient}, nil
+}
+
+// SecretRotationPolicies returns the client for the (cluster-scoped)
+// SecretRotationPolicy resource.
+func (c *Client) SecretRotationPolicies() *SecretRotationPolicyClient {
+	return &SecretRotationPolicyClient{client: c.restClient}
+}
+
+// SecretRotationPolicyClient talks to the secretrotationpolicies resource.
+type SecretRotationPolicyClient struct {
+	client rest.Interface
+}
+
+func (c *SecretRotationPolicyClient) List(ctx context.Context, opts metav1.ListOptions) (*SecretRotationPolicyList, error) {
+	result := &SecretRotationPolicyList{}
+	err := c.client.Get().
+		Resource("secretrotationpolicies").
+		VersionedParams(&opts, ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *SecretRotationPolicyClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return c.client.Get().
+		Resource("secretrotationpolicies").
+		VersionedParams(&opts, ParameterCodec).
+		Watch(ctx)
+}
+
+func (c *SecretRotationPolicyClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*SecretRotationPolicy, error) {
+	result := &SecretRotationPolicy{}
+	err := c.client.Get().
+		Resource("secretrotationpolicies").
+		Name(name).
+		VersionedParams(&opts, ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *SecretRotationPolicyClient) UpdateStatus(ctx context.Context, policy *SecretRotationPolicy, opts metav1.UpdateOptions) (*SecretRotationPolicy, error) {
+	result := &SecretRotationPolicy{}
+	err := c.client.Put().
+		Resource("secretrotationpolicies").
+		Name(policy.Name).
+		SubResource("status").
+		VersionedParams(&opts, ParameterCodec).
+		Body(policy).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
@@ -0,0 +1,104 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto copies all fields of in into out.
+func (in *SecretRotationPolicySpec) DeepCopyInto(out *SecretRotationPolicySpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy returns a deep copy of in.
+func (in *SecretRotationPolicySpec) DeepCopy() *SecretRotationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all fields of in into out.
+func (in *SecretRotationPolicyStatus) DeepCopyInto(out *SecretRotationPolicyStatus) {
+	*out = *in
+	if in.MatchedSecrets != nil {
+		out.MatchedSecrets = make([]MatchedSecret, len(in.MatchedSecrets))
+		copy(out.MatchedSecrets, in.MatchedSecrets)
+	}
+	if in.LastRotationTime != nil {
+		out.LastRotationTime = in.LastRotationTime.DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		out.NextRotationTime = in.NextRotationTime.DeepCopy()
+	}
+}
+
+// DeepCopy returns a deep copy of in.
+func (in *SecretRotationPolicyStatus) DeepCopy() *SecretRotationPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all fields of in into out.
+func (in *SecretRotationPolicy) DeepCopyInto(out *SecretRotationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy returns a deep copy of in.
+func (in *SecretRotationPolicy) DeepCopy() *SecretRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject implements runtime.Object.
+func (in *SecretRotationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies all fields of in into out.
+func (in *SecretRotationPolicyList) DeepCopyInto(out *SecretRotationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]SecretRotationPolicy, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// DeepCopy returns a deep copy of in.
+func (in *SecretRotationPolicyList) DeepCopy() *SecretRotationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRotationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject implements runtime.Object.
+func (in *SecretRotationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Package v1alpha1 contains the SecretRotationPolicy API: a CRD that lets cluster
+// operators declare expiration and rotation rules for a selector-matched set of
+// Secrets, as an alternative to annotating each Secret individually.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretRotationPolicySpec selects a set of Secrets via Selector and describes how
+// they should expire and be rotated. Fields here are defaults: a Secret's own
+// secret-operator.example.com annotations always take precedence over the policy
+// that matches it.
+type SecretRotationPolicySpec struct {
+	// Selector matches the Secrets this policy applies to.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// ExpiresAt is the expiration date (YYYY-MM-DD) applied to matched Secrets that
+	// don't carry their own expires-at annotation.
+	// +optional
+	ExpiresAt string `json:"expiresAt,omitempty"`
+
+	// WarnBefore is how long before ExpiresAt a Secret is considered expiring soon,
+	// as a Go duration or ISO 8601 period (e.g. "168h", "P2W").
+	// +optional
+	WarnBefore string `json:"warnBefore,omitempty"`
+
+	// Rotator is the name of the registered Rotator used to rotate matched Secrets.
+	// +optional
+	Rotator string `json:"rotator,omitempty"`
+
+	// RotationSchedule is a cron expression for rotating matched Secrets on a fixed
+	// cadence, independent of the expiration threshold.
+	// +optional
+	RotationSchedule string `json:"rotationSchedule,omitempty"`
+}
+
+// MatchedSecret identifies one Secret currently selected by a SecretRotationPolicy.
+type MatchedSecret struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// SecretRotationPolicyStatus reports which Secrets a policy currently matches and
+// when it last/next rotated them.
+type SecretRotationPolicyStatus struct {
+	// MatchedSecrets lists the Secrets currently selected by Spec.Selector.
+	// +optional
+	MatchedSecrets []MatchedSecret `json:"matchedSecrets,omitempty"`
+
+	// LastRotationTime is when this policy last rotated a matched Secret.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// NextRotationTime is the next time RotationSchedule is due to fire.
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecretRotationPolicy declaratively configures expiration and rotation for the
+// Secrets matched by Spec.Selector.
+type SecretRotationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretRotationPolicySpec   `json:"spec,omitempty"`
+	Status SecretRotationPolicyStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecretRotationPolicyList is a list of SecretRotationPolicy.
+type SecretRotationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []SecretRotationPolicy `json:"items"`
+}
@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
-	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -14,12 +17,41 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	policyv1alpha1 "github.com/vkukul/k8s-playgrounds/api/v1alpha1"
+	"github.com/vkukul/k8s-playgrounds/internal/controller"
+	"github.com/vkukul/k8s-playgrounds/internal/timeutil"
+)
+
+var (
+	metricsAddr          = flag.String("metrics-addr", ":8080", "Address to serve /metrics, /healthz, and /readyz on")
+	workers              = flag.Int("workers", 2, "Number of reconcile workers")
+	leaderElect          = flag.Bool("leader-elect", false, "Enable leader election so only one of several replicas is active at a time")
+	leaderElectLeaseName = flag.String("leader-elect-lease-name", "secret-operator-leader", "Name of the Lease used for leader election")
+	leaderElectNamespace = flag.String("leader-elect-namespace", "default", "Namespace of the Lease used for leader election")
+	enablePolicyCRD      = flag.Bool("enable-secret-rotation-policy", false, "Watch SecretRotationPolicy resources for selector-based rotation config (requires the CRD from config/crd to be installed)")
 )
 
+// registerBuiltinRotators wires the Rotator implementations that need no extra
+// credentials. Providers backed by an external system (e.g. AWS SSM) need a
+// configured client first; register those the same way once that client exists:
+//
+//	controller.RegisterRotator("aws-ssm", controller.NewAWSSSMRotator(ssmClient, "/secret-operator", "token", 30*24*time.Hour))
+func registerBuiltinRotators() {
+	controller.RegisterRotator("static-token", controller.NewStaticTokenRotator(32, 90*24*time.Hour))
+	controller.RegisterRotator("tls-self-signed", controller.NewTLSCertRotator(90*24*time.Hour))
+}
+
 func main() {
+	flag.Parse()
+
 	fmt.Println("Secret Rotation Operator - Starting up...")
 	fmt.Println("==========================================")
 
+	registerBuiltinRotators()
+
 	config, err := buildConfig()
 	if err != nil {
 		fmt.Printf("Error building kubeconfig: %v\n", err)
@@ -36,6 +68,16 @@ func main() {
 
 	fmt.Println("✓ Created Kubernetes clientset")
 
+	var policyClient *policyv1alpha1.Client
+	if *enablePolicyCRD {
+		policyClient, err = policyv1alpha1.NewForConfig(config)
+		if err != nil {
+			fmt.Printf("Error creating SecretRotationPolicy client: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("✓ Created SecretRotationPolicy client")
+	}
+
 	fmt.Println("\nVerifying cluster connection...")
 	if err := verifyConnection(clientset); err != nil {
 		fmt.Printf("Error connecting to cluster: %v\n", err)
@@ -52,8 +94,94 @@ func main() {
 	}
 
 	fmt.Println("\n==========================================")
-	fmt.Println("Secret Rotation Operator - Ready!")
-	fmt.Println("(Controller logic will be added in Phase 3)")
+	fmt.Println("Phase 3: Starting Secret Rotation Controller")
+	fmt.Println("==========================================")
+
+	runSecretController(clientset, policyClient)
+}
+
+// runSecretController builds the controller and runs it until it's interrupted,
+// optionally behind leader election so only one of several replicas is ever active.
+// policyClient may be nil, in which case rotation config comes only from Secret
+// annotations (see --enable-secret-rotation-policy).
+func runSecretController(clientset *kubernetes.Clientset, policyClient *policyv1alpha1.Client) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if !*leaderElect {
+		runController(ctx, controller.NewSecretController(clientset, policyClient, *metricsAddr))
+		return
+	}
+
+	id, err := os.Hostname()
+	if err != nil {
+		fmt.Printf("Error determining hostname for leader election identity: %v\n", err)
+		os.Exit(1)
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      *leaderElectLeaseName,
+			Namespace: *leaderElectNamespace,
+		},
+		Client: clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: id,
+		},
+	}
+
+	// ctrl holds the controller for the current leadership term only, guarded by
+	// ctrlMu since OnStartedLeading and OnStoppedLeading run on different goroutines.
+	// The controller is built only once this replica starts leading, so a standby
+	// never starts SecretController's event broadcaster (which can't be restarted once
+	// Stop has shut it down). RunOrDie returns when leadership is lost, so this
+	// function leads at most one term. ctrl stays nil if this replica is terminated while still a
+	// standby (OnStoppedLeading still runs in that case, since LeaderElector.Run
+	// defers it unconditionally).
+	var (
+		ctrlMu sync.Mutex
+		ctrl   *controller.SecretController
+	)
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				ctrlMu.Lock()
+				ctrl = controller.NewSecretController(clientset, policyClient, *metricsAddr)
+				c := ctrl
+				ctrlMu.Unlock()
+				runController(ctx, c)
+			},
+			OnStoppedLeading: func() {
+				ctrlMu.Lock()
+				c := ctrl
+				ctrlMu.Unlock()
+				if c == nil {
+					return
+				}
+				fmt.Println("Lost leadership, shutting down controller")
+				c.Stop()
+			},
+			OnNewLeader: func(identity string) {
+				if identity != id {
+					fmt.Printf("New leader elected: %s\n", identity)
+				}
+			},
+		},
+	})
+}
+
+// runController runs ctrl until ctx is done, exiting the process if it returns an error.
+func runController(ctx context.Context, ctrl *controller.SecretController) {
+	if err := ctrl.Run(ctx, *workers); err != nil {
+		fmt.Printf("Controller exited with error: %v\n", err)
+		os.Exit(1)
+	}
 }
 
 func buildConfig() (*rest.Config, error) {
@@ -149,7 +277,7 @@ func parseSecretInfo(secret *corev1.Secret) (SecretInfo, bool) {
 
 	warnBefore := 7 * 24 * time.Hour
 	if warnStr, hasWarn := secret.Annotations[AnnotationWarnBefore]; hasWarn {
-		if parsed, err := parseDuration(warnStr); err == nil {
+		if parsed, err := timeutil.ParseDuration(warnStr); err == nil {
 			warnBefore = parsed
 		} else {
 			fmt.Printf("Warning: Secret %s/%s has invalid warn-before format: %v (using default 7d)\n",
@@ -169,21 +297,6 @@ func parseSecretInfo(secret *corev1.Secret) (SecretInfo, bool) {
 	}, true
 }
 
-func parseDuration(s string) (time.Duration, error) {
-	if len(s) > 1 && s[len(s)-1] == 'd' {
-		daysStr := strings.TrimSuffix(s, "d")
-
-		days, err := strconv.Atoi(daysStr)
-		if err != nil {
-			return 0, fmt.Errorf("invalid days format: %w", err)
-		}
-
-		return time.Duration(days) * 24 * time.Hour, nil
-	}
-
-	return time.ParseDuration(s)
-}
-
 func displaySecretInfo(info SecretInfo) {
 	fmt.Printf("\nSecret: %s/%s\n", info.Namespace, info.Name)
 	fmt.Printf("  Expires: %s\n", info.ExpiresAt.Format("2006-01-02 (Monday)"))
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed 5-field cron expression (minute hour dom month dow),
+// each field reduced to the set of values it permits within its range.
+type cronSchedule struct {
+	minute, hour, dom, month, dow map[int]bool
+
+	// domRestricted and dowRestricted record whether the day-of-month/day-of-week
+	// fields were restricted (anything other than "*"), so Next can apply the standard cron rule: when both are
+	// restricted a day need only satisfy one of them, not both.
+	domRestricted, dowRestricted bool
+}
+
+// parseCronSchedule parses a standard 5-field cron expression: minute (0-59), hour
+// (0-23), day-of-month (1-31), month (1-12), and day-of-week (0-6, Sunday is 0). Each
+// field accepts "*", a single value, a range "a-b", a step "a/n" or "*/n", or a
+// comma-separated list of any of those.
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	minute, err := parseCronField(fields[0], 0, 59)
+	if err != nil {
+		return nil, fmt.Errorf("cron expression %q: minute field: %w", expr, err)
+	}
+	hour, err := parseCronField(fields[1], 0, 23)
+	if err != nil {
+		return nil, fmt.Errorf("cron expression %q: hour field: %w", expr, err)
+	}
+	dom, err := parseCronField(fields[2], 1, 31)
+	if err != nil {
+		return nil, fmt.Errorf("cron expression %q: day-of-month field: %w", expr, err)
+	}
+	month, err := parseCronField(fields[3], 1, 12)
+	if err != nil {
+		return nil, fmt.Errorf("cron expression %q: month field: %w", expr, err)
+	}
+	dow, err := parseCronField(fields[4], 0, 6)
+	if err != nil {
+		return nil, fmt.Errorf("cron expression %q: day-of-week field: %w", expr, err)
+	}
+
+	return &cronSchedule{
+		minute: minute, hour: hour, dom: dom, month: month, dow: dow,
+		domRestricted: fields[2] != "*",
+		dowRestricted: fields[4] != "*",
+	}, nil
+}
+
+// parseCronField parses one comma-separated cron field into the set of values it
+// permits, each of which must fall within [min, max].
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	set := map[int]bool{}
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepStr, hasStep := strings.Cut(part, "/")
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			if from, to, hasRange := strings.Cut(rangePart, "-"); hasRange {
+				var err error
+				lo, err = strconv.Atoi(from)
+				if err != nil {
+					return nil, fmt.Errorf("invalid range start %q", from)
+				}
+				hi, err = strconv.Atoi(to)
+				if err != nil {
+					return nil, fmt.Errorf("invalid range end %q", to)
+				}
+			} else {
+				v, err := strconv.Atoi(rangePart)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value %q", rangePart)
+				}
+				lo, hi = v, v
+				if hasStep {
+					// "a/n" steps from a up to the end of the field's range.
+					hi = max
+				}
+			}
+		}
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepStr)
+			}
+		}
+
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("value %q out of range [%d, %d]", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+
+	return set, nil
+}
+
+// Next returns the earliest time strictly after `after` that matches the schedule,
+// truncated to the minute. It returns an error if no match is found within 4 years,
+// which can only happen for a field combination with no valid values (e.g. "31 2 *"
+// asking for Feb 31st with no day-of-week fallback).
+func (cs *cronSchedule) Next(after time.Time) (time.Time, error) {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.AddDate(4, 0, 0)
+
+	for !t.After(limit) {
+		if !cs.month[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			continue
+		}
+		if !cs.domMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+		if !cs.hour[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+			continue
+		}
+		if !cs.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("no matching time within 4 years of %s", after.Format(time.RFC3339))
+}
+
+// domMatches applies the standard cron rule for combining day-of-month and
+// day-of-week: if only one of the two fields is restricted (not "*"), it alone
+// decides; if both are restricted, either matching is sufficient.
+func (cs *cronSchedule) domMatches(t time.Time) bool {
+	domOK := cs.dom[t.Day()]
+	dowOK := cs.dow[int(t.Weekday())]
+
+	switch {
+	case cs.domRestricted && cs.dowRestricted:
+		return domOK || dowOK
+	case cs.domRestricted:
+		return domOK
+	case cs.dowRestricted:
+		return dowOK
+	default:
+		return true
+	}
+}
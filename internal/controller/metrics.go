@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var (
+	trackedSecretsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "secret_operator_tracked_secrets",
+		Help: "Number of Secrets currently tracked for expiration.",
+	})
+
+	daysUntilExpirationGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "secret_operator_days_until_expiration",
+		Help: "Days remaining until a tracked Secret expires (negative if already expired).",
+	}, []string{"namespace", "name"})
+
+	expiredTotalCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "secret_operator_expired_total",
+		Help: "Total number of times a tracked Secret was observed past its expiration.",
+	})
+)
+
+func init() {
+	workqueue.SetProvider(newWorkqueueMetricsProvider())
+}
+
+// workqueueMetricsProvider exposes the controller's workqueue depth, latency, and
+// retry counts as Prometheus metrics, keyed by queue name the way client-go's
+// workqueue package expects.
+//
+// client-go calls New*Metric again every time a workqueue is constructed, which
+// happens once per SecretController (one per leader election term, see main.go), so
+// the provider caches the collector it returns for each name rather than registering
+// a fresh one on every call: promauto.New* panics on duplicate registration, and every
+// SecretController here builds a queue with the same name ("secrets").
+type workqueueMetricsProvider struct {
+	mu             sync.Mutex
+	depth          map[string]workqueue.GaugeMetric
+	adds           map[string]workqueue.CounterMetric
+	latency        map[string]workqueue.HistogramMetric
+	workDuration   map[string]workqueue.HistogramMetric
+	unfinished     map[string]workqueue.SettableGaugeMetric
+	longestRunning map[string]workqueue.SettableGaugeMetric
+	retries        map[string]workqueue.CounterMetric
+}
+
+func newWorkqueueMetricsProvider() *workqueueMetricsProvider {
+	return &workqueueMetricsProvider{
+		depth:          make(map[string]workqueue.GaugeMetric),
+		adds:           make(map[string]workqueue.CounterMetric),
+		latency:        make(map[string]workqueue.HistogramMetric),
+		workDuration:   make(map[string]workqueue.HistogramMetric),
+		unfinished:     make(map[string]workqueue.SettableGaugeMetric),
+		longestRunning: make(map[string]workqueue.SettableGaugeMetric),
+		retries:        make(map[string]workqueue.CounterMetric),
+	}
+}
+
+func (p *workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.depth[name]; ok {
+		return m
+	}
+	m := promauto.NewGauge(prometheus.GaugeOpts{
+		Name:        "secret_operator_workqueue_depth",
+		Help:        "Current depth of the workqueue.",
+		ConstLabels: prometheus.Labels{"queue_name": name},
+	})
+	p.depth[name] = m
+	return m
+}
+
+func (p *workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.adds[name]; ok {
+		return m
+	}
+	m := promauto.NewCounter(prometheus.CounterOpts{
+		Name:        "secret_operator_workqueue_adds_total",
+		Help:        "Total number of items added to the workqueue.",
+		ConstLabels: prometheus.Labels{"queue_name": name},
+	})
+	p.adds[name] = m
+	return m
+}
+
+func (p *workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.latency[name]; ok {
+		return m
+	}
+	m := promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:        "secret_operator_workqueue_latency_seconds",
+		Help:        "How long an item sits in the workqueue before being processed.",
+		ConstLabels: prometheus.Labels{"queue_name": name},
+	})
+	p.latency[name] = m
+	return m
+}
+
+func (p *workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.workDuration[name]; ok {
+		return m
+	}
+	m := promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:        "secret_operator_workqueue_work_duration_seconds",
+		Help:        "How long processing an item from the workqueue takes.",
+		ConstLabels: prometheus.Labels{"queue_name": name},
+	})
+	p.workDuration[name] = m
+	return m
+}
+
+func (p *workqueueMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.unfinished[name]; ok {
+		return m
+	}
+	m := promauto.NewGauge(prometheus.GaugeOpts{
+		Name:        "secret_operator_workqueue_unfinished_work_seconds",
+		Help:        "How long in-progress items have been in the workqueue.",
+		ConstLabels: prometheus.Labels{"queue_name": name},
+	})
+	p.unfinished[name] = m
+	return m
+}
+
+func (p *workqueueMetricsProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.longestRunning[name]; ok {
+		return m
+	}
+	m := promauto.NewGauge(prometheus.GaugeOpts{
+		Name:        "secret_operator_workqueue_longest_running_processor_seconds",
+		Help:        "Age of the oldest in-progress item in the workqueue.",
+		ConstLabels: prometheus.Labels{"queue_name": name},
+	})
+	p.longestRunning[name] = m
+	return m
+}
+
+func (p *workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if m, ok := p.retries[name]; ok {
+		return m
+	}
+	m := promauto.NewCounter(prometheus.CounterOpts{
+		Name:        "secret_operator_workqueue_retries_total",
+		Help:        "Total number of times an item was retried.",
+		ConstLabels: prometheus.Labels{"queue_name": name},
+	})
+	p.retries[name] = m
+	return m
+}
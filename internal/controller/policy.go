@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	policyv1alpha1 "github.com/vkukul/k8s-playgrounds/api/v1alpha1"
+	"github.com/vkukul/k8s-playgrounds/internal/timeutil"
+)
+
+// newPolicyInformer builds a SharedIndexInformer over SecretRotationPolicy objects,
+// using the same ListWatch shape the built-in informer factories generate for
+// core types.
+func newPolicyInformer(policyClient *policyv1alpha1.Client, resync time.Duration) cache.SharedIndexInformer {
+	lw := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return policyClient.SecretRotationPolicies().List(context.TODO(), opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return policyClient.SecretRotationPolicies().Watch(context.TODO(), opts)
+		},
+	}
+
+	return cache.NewSharedIndexInformer(lw, &policyv1alpha1.SecretRotationPolicy{}, resync, cache.Indexers{})
+}
+
+// effectivePolicyFor returns the SecretRotationPolicy whose selector matches secret's
+// labels, if any. If more than one policy matches, the oldest one (by
+// CreationTimestamp, falling back to lexicographically-least name to break ties
+// between Policies created in the same second) wins, so the choice is deterministic
+// across reconciles instead of depending on Go's randomized map iteration order;
+// policies intended for the same Secrets should still be merged by the cluster
+// operator rather than relied on for a specific precedence order.
+func effectivePolicyFor(policyInformer cache.SharedIndexInformer, secret *corev1.Secret) *policyv1alpha1.SecretRotationPolicy {
+	if policyInformer == nil {
+		return nil
+	}
+
+	secretLabels := labels.Set(secret.Labels)
+
+	var effective *policyv1alpha1.SecretRotationPolicy
+	for _, obj := range policyInformer.GetStore().List() {
+		policy, ok := obj.(*policyv1alpha1.SecretRotationPolicy)
+		if !ok {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.Selector)
+		if err != nil {
+			klog.Errorf("SecretRotationPolicy %s has invalid selector: %v", policy.Name, err)
+			continue
+		}
+
+		if selector.Matches(secretLabels) && olderPolicy(policy, effective) {
+			effective = policy
+		}
+	}
+
+	return effective
+}
+
+// olderPolicy reports whether candidate should win over current (which may be nil) as
+// the effective policy: an earlier CreationTimestamp wins, with a lexicographically
+// smaller name breaking ties.
+func olderPolicy(candidate, current *policyv1alpha1.SecretRotationPolicy) bool {
+	if current == nil {
+		return true
+	}
+	candidateTime := candidate.CreationTimestamp.Time
+	currentTime := current.CreationTimestamp.Time
+	if !candidateTime.Equal(currentTime) {
+		return candidateTime.Before(currentTime)
+	}
+	return candidate.Name < current.Name
+}
+
+// resolveSecretInfo computes SecretInfo for secret, letting an effective
+// SecretRotationPolicy fill in expiresAt/warnBefore that the Secret's own
+// annotations don't set. Per-Secret annotations always take precedence over the
+// policy, so annotation-only Secrets keep working unmodified.
+func resolveSecretInfo(secret *corev1.Secret, policy *policyv1alpha1.SecretRotationPolicy) (SecretInfo, bool) {
+	expiresAtStr, hasExpiration := secret.Annotations[AnnotationExpiresAt]
+	if !hasExpiration && policy != nil && policy.Spec.ExpiresAt != "" {
+		expiresAtStr, hasExpiration = policy.Spec.ExpiresAt, true
+	}
+	if !hasExpiration {
+		return SecretInfo{}, false
+	}
+
+	expTime, err := time.Parse("2006-01-02", expiresAtStr)
+	if err != nil {
+		klog.Warningf("Secret %s/%s has invalid expires-at format: %v", secret.Namespace, secret.Name, err)
+		return SecretInfo{}, false
+	}
+
+	warnBefore := 7 * 24 * time.Hour
+	warnStr, hasWarn := secret.Annotations[AnnotationWarnBefore]
+	if !hasWarn && policy != nil && policy.Spec.WarnBefore != "" {
+		warnStr, hasWarn = policy.Spec.WarnBefore, true
+	}
+	if hasWarn {
+		if parsed, err := timeutil.ParseDuration(warnStr); err == nil {
+			warnBefore = parsed
+		} else {
+			klog.Warningf("Secret %s/%s has invalid warn-before format: %v (using default 7d)",
+				secret.Namespace, secret.Name, err)
+		}
+	}
+
+	return SecretInfo{
+		Name:         secret.Name,
+		Namespace:    secret.Namespace,
+		ExpiresAt:    expTime,
+		WarnBefore:   warnBefore,
+		DaysUntilExp: int(expTime.Sub(time.Now()).Hours() / 24),
+	}, true
+}
+
+// effectiveRotator returns the name of the Rotator that should handle secret's
+// rotation: its own AnnotationRotator annotation if set, else the matching policy's
+// Rotator field.
+func effectiveRotator(secret *corev1.Secret, policy *policyv1alpha1.SecretRotationPolicy) (string, bool) {
+	if name, ok := secret.Annotations[AnnotationRotator]; ok {
+		return name, true
+	}
+	if policy != nil && policy.Spec.Rotator != "" {
+		return policy.Spec.Rotator, true
+	}
+	return "", false
+}
+
+// policyRotationDue reports whether policy's RotationSchedule cron expression is due
+// to fire at or before now, independent of any expiration threshold: ok is false if
+// policy has no RotationSchedule or the expression is invalid.
+func policyRotationDue(policy *policyv1alpha1.SecretRotationPolicy, now time.Time) (due bool, ok bool) {
+	if policy == nil || policy.Spec.RotationSchedule == "" {
+		return false, false
+	}
+
+	from := now.Add(-time.Minute)
+	if policy.Status.LastRotationTime != nil {
+		from = policy.Status.LastRotationTime.Time
+	}
+
+	next, ok := nextCronFire(policy, from)
+	if !ok {
+		return false, false
+	}
+
+	return !next.After(now), true
+}
+
+// nextCronFire returns the time policy's RotationSchedule will next fire strictly
+// after `after`, ignoring LastRotationTime. ok is false if policy has no
+// RotationSchedule or the expression is invalid.
+func nextCronFire(policy *policyv1alpha1.SecretRotationPolicy, after time.Time) (next time.Time, ok bool) {
+	if policy == nil || policy.Spec.RotationSchedule == "" {
+		return time.Time{}, false
+	}
+
+	schedule, err := parseCronSchedule(policy.Spec.RotationSchedule)
+	if err != nil {
+		klog.Errorf("SecretRotationPolicy %s has invalid rotationSchedule: %v", policy.Name, err)
+		return time.Time{}, false
+	}
+
+	next, err = schedule.Next(after)
+	if err != nil {
+		klog.Errorf("SecretRotationPolicy %s: computing next rotationSchedule fire time: %v", policy.Name, err)
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+// recordRotation stamps policy's LastRotationTime and pushes the update to the API
+// server. Failures are logged rather than propagated: a stale status shouldn't block
+// the rotation that already succeeded.
+func recordRotation(policyClient *policyv1alpha1.Client, policy *policyv1alpha1.SecretRotationPolicy) {
+	if policyClient == nil || policy == nil {
+		return
+	}
+
+	updated := policy.DeepCopy()
+	now := metav1.Now()
+	updated.Status.LastRotationTime = &now
+
+	if _, err := policyClient.SecretRotationPolicies().UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("Failed to update status for SecretRotationPolicy %s: %v", policy.Name, err)
+	}
+}
+
+// syncPolicyStatus recomputes policy.Status.MatchedSecrets from the Secret informer's
+// store and pushes the update to the API server. Called whenever a policy is added,
+// updated or deleted (including the policy informer's periodic resync); a change to a
+// Secret's labels alone does not trigger it.
+func syncPolicyStatus(policyClient *policyv1alpha1.Client, secretInformer cache.SharedIndexInformer, policy *policyv1alpha1.SecretRotationPolicy) {
+	if policyClient == nil {
+		return
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.Selector)
+	if err != nil {
+		klog.Errorf("SecretRotationPolicy %s has invalid selector: %v", policy.Name, err)
+		return
+	}
+
+	var matched []policyv1alpha1.MatchedSecret
+	for _, obj := range secretInformer.GetStore().List() {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			continue
+		}
+		if selector.Matches(labels.Set(secret.Labels)) {
+			matched = append(matched, policyv1alpha1.MatchedSecret{Namespace: secret.Namespace, Name: secret.Name})
+		}
+	}
+
+	updated := policy.DeepCopy()
+	updated.Status.MatchedSecrets = matched
+
+	if policy.Spec.RotationSchedule != "" {
+		from := time.Now()
+		if policy.Status.LastRotationTime != nil {
+			from = policy.Status.LastRotationTime.Time
+		}
+		if next, ok := nextCronFire(policy, from); ok {
+			nextRotation := metav1.NewTime(next)
+			updated.Status.NextRotationTime = &nextRotation
+		}
+	}
+
+	if _, err := policyClient.SecretRotationPolicies().UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("Failed to update status for SecretRotationPolicy %s: %v", policy.Name, err)
+	}
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// AnnotationRotator selects which registered Rotator should handle a Secret's
+// rotation once it crosses its warning threshold.
+const AnnotationRotator = "secret-operator.example.com/rotator"
+
+// Rotator produces fresh Secret data and a new expiration time for a Secret that is
+// expiring soon or has already expired. Implementations must not mutate the Secret
+// passed to them; the controller owns patching the result back onto the object.
+type Rotator interface {
+	Rotate(ctx context.Context, secret *corev1.Secret) (data map[string][]byte, expiresAt time.Time, err error)
+}
+
+var (
+	rotatorsMu sync.RWMutex
+	rotators   = map[string]Rotator{}
+)
+
+// RegisterRotator makes a Rotator available under name so Secrets can opt in via the
+// AnnotationRotator annotation. Call it from main() before Run starts so the rotator
+// is available from the first reconcile; the registry is guarded by a lock, so calling
+// it while a controller is running is also safe.
+func RegisterRotator(name string, r Rotator) {
+	rotatorsMu.Lock()
+	defer rotatorsMu.Unlock()
+	rotators[name] = r
+}
+
+// lookupRotator returns the Rotator registered under name, if any.
+func lookupRotator(name string) (Rotator, bool) {
+	rotatorsMu.RLock()
+	defer rotatorsMu.RUnlock()
+	r, ok := rotators[name]
+	return r, ok
+}
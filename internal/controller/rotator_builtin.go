@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// StaticTokenRotator generates a random token/password and stores it under a single
+// data key. It suits credentials with no external system of record, e.g. webhook
+// signing keys or shared application passwords.
+type StaticTokenRotator struct {
+	DataKey  string
+	Length   int
+	ValidFor time.Duration
+}
+
+// NewStaticTokenRotator returns a StaticTokenRotator producing length-byte tokens
+// (base64-encoded) under the "token" data key, valid for validFor.
+func NewStaticTokenRotator(length int, validFor time.Duration) *StaticTokenRotator {
+	return &StaticTokenRotator{DataKey: "token", Length: length, ValidFor: validFor}
+}
+
+func (r *StaticTokenRotator) Rotate(_ context.Context, _ *corev1.Secret) (map[string][]byte, time.Time, error) {
+	raw := make([]byte, r.Length)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, time.Time{}, fmt.Errorf("generating random token: %w", err)
+	}
+
+	data := map[string][]byte{
+		r.DataKey: []byte(base64.RawURLEncoding.EncodeToString(raw)),
+	}
+	return data, time.Now().Add(r.ValidFor), nil
+}
+
+// TLSCertRotator issues a fresh TLS keypair under the conventional "tls.crt"/"tls.key"
+// data keys, named after the Secret it rotates. In a cluster running cert-manager this
+// would submit a CertificateRequest and poll it to Ready; here it self-signs, which is
+// enough for dev/test environments and gives callers a drop-in point to swap in a real
+// cert-manager client without touching the controller.
+type TLSCertRotator struct {
+	ValidFor time.Duration
+}
+
+// NewTLSCertRotator returns a TLSCertRotator whose issued certificates are valid for
+// validFor.
+func NewTLSCertRotator(validFor time.Duration) *TLSCertRotator {
+	return &TLSCertRotator{ValidFor: validFor}
+}
+
+func (r *TLSCertRotator) Rotate(_ context.Context, secret *corev1.Secret) (map[string][]byte, time.Time, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("generating key: %w", err)
+	}
+
+	notBefore := time.Now()
+	notAfter := notBefore.Add(r.ValidFor)
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("generating serial number: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: secret.Name},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("creating certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("marshaling key: %w", err)
+	}
+
+	data := map[string][]byte{
+		"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		"tls.key": pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+	return data, notAfter, nil
+}
+
+// SSMClient is the subset of an AWS SSM Parameter Store client that AWSSSMRotator
+// needs. It is defined here rather than imported from the AWS SDK so the controller
+// has no hard dependency on AWS; callers inject whatever client satisfies it.
+type SSMClient interface {
+	GetParameter(ctx context.Context, name string) (value string, err error)
+}
+
+// AWSSSMRotator fetches the current value of an external SSM parameter and stores it
+// under a data key, treating SSM (or an equivalent secrets manager) as the system of
+// record for the credential rather than generating one locally.
+type AWSSSMRotator struct {
+	Client          SSMClient
+	ParameterPrefix string
+	DataKey         string
+	ValidFor        time.Duration
+}
+
+// NewAWSSSMRotator returns an AWSSSMRotator that reads "<parameterPrefix>/<secret
+// name>" from client and stores the result under dataKey, valid for validFor.
+func NewAWSSSMRotator(client SSMClient, parameterPrefix, dataKey string, validFor time.Duration) *AWSSSMRotator {
+	return &AWSSSMRotator{Client: client, ParameterPrefix: parameterPrefix, DataKey: dataKey, ValidFor: validFor}
+}
+
+func (r *AWSSSMRotator) Rotate(ctx context.Context, secret *corev1.Secret) (map[string][]byte, time.Time, error) {
+	paramName := fmt.Sprintf("%s/%s", r.ParameterPrefix, secret.Name)
+
+	value, err := r.Client.GetParameter(ctx, paramName)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("fetching SSM parameter %s: %w", paramName, err)
+	}
+
+	data := map[string][]byte{
+		r.DataKey: []byte(value),
+	}
+	return data, time.Now().Add(r.ValidFor), nil
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeRotator struct{}
+
+func (fakeRotator) Rotate(context.Context, *corev1.Secret) (map[string][]byte, time.Time, error) {
+	return nil, time.Time{}, nil
+}
+
+func TestRegisterAndLookupRotator(t *testing.T) {
+	RegisterRotator("test-fake-rotator", fakeRotator{})
+
+	if _, ok := lookupRotator("test-fake-rotator"); !ok {
+		t.Fatal("lookupRotator did not find a rotator registered under the same name")
+	}
+
+	if _, ok := lookupRotator("test-unregistered-rotator"); ok {
+		t.Fatal("lookupRotator found a rotator that was never registered")
+	}
+}
+
+func TestStaticTokenRotatorRotate(t *testing.T) {
+	r := NewStaticTokenRotator(16, time.Hour)
+
+	data, expiresAt, err := r.Rotate(context.Background(), &corev1.Secret{})
+	if err != nil {
+		t.Fatalf("Rotate returned unexpected error: %v", err)
+	}
+
+	token, ok := data[r.DataKey]
+	if !ok {
+		t.Fatalf("Rotate did not produce a value under data key %q", r.DataKey)
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(string(token)); err != nil {
+		t.Errorf("token is not valid base64.RawURLEncoding: %v", err)
+	}
+
+	wantExpiry := time.Now().Add(time.Hour)
+	if diff := expiresAt.Sub(wantExpiry); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("expiresAt = %v, want close to %v", expiresAt, wantExpiry)
+	}
+}
+
+func TestTLSCertRotatorRotate(t *testing.T) {
+	r := NewTLSCertRotator(24 * time.Hour)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "example-tls"}}
+
+	data, expiresAt, err := r.Rotate(context.Background(), secret)
+	if err != nil {
+		t.Fatalf("Rotate returned unexpected error: %v", err)
+	}
+
+	certPEM, ok := data["tls.crt"]
+	if !ok {
+		t.Fatal(`Rotate did not produce a "tls.crt" entry`)
+	}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		t.Fatal("tls.crt is not valid PEM")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("parsing generated certificate: %v", err)
+	}
+
+	if cert.Subject.CommonName != "example-tls" {
+		t.Errorf("cert CommonName = %q, want %q", cert.Subject.CommonName, "example-tls")
+	}
+	// x509 certificate timestamps have only second-level precision, so compare with
+	// a tolerance rather than requiring exact equality with expiresAt.
+	if diff := cert.NotAfter.Sub(expiresAt); diff < -time.Second || diff > time.Second {
+		t.Errorf("cert.NotAfter = %v, want close to %v", cert.NotAfter, expiresAt)
+	}
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNextBoundary(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		info       SecretInfo
+		wantOK     bool
+		wantAround time.Duration
+	}{
+		{
+			name:       "before warn window",
+			info:       SecretInfo{ExpiresAt: now.Add(30 * 24 * time.Hour), WarnBefore: 7 * 24 * time.Hour},
+			wantOK:     true,
+			wantAround: 23 * 24 * time.Hour,
+		},
+		{
+			name:       "inside warn window",
+			info:       SecretInfo{ExpiresAt: now.Add(3 * 24 * time.Hour), WarnBefore: 7 * 24 * time.Hour},
+			wantOK:     true,
+			wantAround: 3 * 24 * time.Hour,
+		},
+		{
+			name:   "already expired",
+			info:   SecretInfo{ExpiresAt: now.Add(-24 * time.Hour), WarnBefore: 7 * 24 * time.Hour},
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := nextBoundary(tt.info, now)
+			if ok != tt.wantOK {
+				t.Fatalf("nextBoundary() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && delay != tt.wantAround {
+				t.Errorf("nextBoundary() delay = %v, want %v", delay, tt.wantAround)
+			}
+		})
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	if got := withJitter(0); got != 0 {
+		t.Errorf("withJitter(0) = %v, want 0", got)
+	}
+	if got := withJitter(-time.Second); got != -time.Second {
+		t.Errorf("withJitter(negative) = %v, want unchanged", got)
+	}
+
+	d := time.Hour
+	lo, hi := d-d/10, d+d/10
+	for i := 0; i < 100; i++ {
+		if got := withJitter(d); got < lo || got > hi {
+			t.Fatalf("withJitter(%v) = %v, want within [%v, %v]", d, got, lo, hi)
+		}
+	}
+}
+
+func TestGenerationTracking(t *testing.T) {
+	c := NewSecretController(fake.NewSimpleClientset(), nil, "")
+
+	c.enqueue("default/example")
+	req := reconcileRequest{Key: "default/example", Generation: 1}
+	if !c.isCurrentGeneration(req) {
+		t.Fatal("expected generation 1 request to be current right after enqueue")
+	}
+
+	c.enqueue("default/example")
+	if c.isCurrentGeneration(req) {
+		t.Fatal("expected generation 1 request to be stale after a second enqueue")
+	}
+}
+
+func TestGenerationTrackingInvalidate(t *testing.T) {
+	c := NewSecretController(fake.NewSimpleClientset(), nil, "")
+
+	c.scheduleAfter("default/example", time.Hour)
+	req := reconcileRequest{Key: "default/example", Generation: 1}
+	if !c.isCurrentGeneration(req) {
+		t.Fatal("expected generation 1 request to be current right after scheduleAfter")
+	}
+
+	c.invalidate("default/example")
+	if c.isCurrentGeneration(req) {
+		t.Fatal("expected generation 1 request to be stale after invalidate")
+	}
+}
@@ -1,12 +1,17 @@
 package controller
 
 import (
+	"context"
 	"fmt"
-	"strconv"
-	"strings"
+	"math/rand"
+	"net/http"
+	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
@@ -17,6 +22,9 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+
+	policyv1alpha1 "github.com/vkukul/k8s-playgrounds/api/v1alpha1"
+	"github.com/vkukul/k8s-playgrounds/internal/timeutil"
 )
 
 const (
@@ -27,10 +35,34 @@ const (
 type SecretController struct {
 	clientset   kubernetes.Interface
 	informer    cache.SharedIndexInformer
-	workqueue   workqueue.TypedRateLimitingInterface[string]
+	workqueue   workqueue.TypedRateLimitingInterface[reconcileRequest]
 	recorder    record.EventRecorder
 	broadcaster record.EventBroadcaster
-	stopCh      chan struct{}
+	cancel      context.CancelFunc
+
+	metricsAddr string
+	httpServer  *http.Server
+
+	// policyClient and policyInformer are nil when the controller is run without a
+	// SecretRotationPolicy CRD client, in which case per-Secret annotations are the
+	// only source of configuration.
+	policyClient   *policyv1alpha1.Client
+	policyInformer cache.SharedIndexInformer
+
+	// generation tracks, per Secret key, the sequence number of the most recent
+	// enqueue. A delayed reconcileRequest whose Generation no longer matches the
+	// current value is stale — superseded by a more recent trigger, or invalidated
+	// by a delete — and is dropped instead of processed.
+	genMu      sync.Mutex
+	generation map[string]uint64
+}
+
+// reconcileRequest is a workqueue item identifying both a Secret key and the
+// enqueue generation it was scheduled under, so stale delayed entries can be told
+// apart from the latest one for the same key.
+type reconcileRequest struct {
+	Key        string
+	Generation uint64
 }
 
 type SecretInfo struct {
@@ -41,13 +73,19 @@ type SecretInfo struct {
 	DaysUntilExp int
 }
 
-// NewSecretController creates a controller with an informer, workqueue, event recorder, and event handlers
-func NewSecretController(clientset kubernetes.Interface) *SecretController {
-	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+// NewSecretController creates a controller with an informer, workqueue, event recorder, and event handlers.
+// metricsAddr is the address Run serves /metrics, /healthz, and /readyz on (e.g. ":8080").
+// policyClient may be nil, in which case rotation config comes only from Secret annotations.
+func NewSecretController(clientset kubernetes.Interface, policyClient *policyv1alpha1.Client, metricsAddr string) *SecretController {
+	// No periodic resync: expiration/warn-threshold crossings are instead caught by
+	// the per-key AddAfter scheduling reconcile sets up for itself (see nextBoundary).
+	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
 	secretInformer := informerFactory.Core().V1().Secrets().Informer()
 
-	queue := workqueue.NewTypedRateLimitingQueue(
-		workqueue.DefaultTypedControllerRateLimiter[string](),
+	// The queue needs a name: client-go records no workqueue metrics for unnamed queues.
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		workqueue.DefaultTypedControllerRateLimiter[reconcileRequest](),
+		workqueue.TypedRateLimitingQueueConfig[reconcileRequest]{Name: "secrets"},
 	)
 
 	// EventBroadcaster receives events and sends them to the API server
@@ -62,12 +100,14 @@ func NewSecretController(clientset kubernetes.Interface) *SecretController {
 	})
 
 	controller := &SecretController{
-		clientset:   clientset,
-		informer:    secretInformer,
-		workqueue:   queue,
-		recorder:    recorder,
-		broadcaster: broadcaster,
-		stopCh:      make(chan struct{}),
+		clientset:    clientset,
+		informer:     secretInformer,
+		workqueue:    queue,
+		recorder:     recorder,
+		broadcaster:  broadcaster,
+		metricsAddr:  metricsAddr,
+		policyClient: policyClient,
+		generation:   make(map[string]uint64),
 	}
 
 	secretInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -76,28 +116,55 @@ func NewSecretController(clientset kubernetes.Interface) *SecretController {
 		DeleteFunc: controller.handleDelete,
 	})
 
+	if policyClient != nil {
+		controller.policyInformer = newPolicyInformer(policyClient, 30*time.Second)
+		controller.policyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    controller.handlePolicyChange,
+			UpdateFunc: func(_, newObj interface{}) { controller.handlePolicyChange(newObj) },
+			DeleteFunc: controller.handlePolicyChange,
+		})
+	}
+
 	return controller
 }
 
-// Run starts the informer and launches the given number of workers to process the queue
-func (c *SecretController) Run(workers int) error {
+// Run starts the informer(s) and launches the given number of workers to process the
+// queue. It blocks until ctx is done, which happens either because the caller
+// cancelled it directly or because Stop was called. Run can also be called from a
+// leaderelection.LeaderCallbacks.OnStartedLeading callback with the lease's context,
+// in which case it stops when the lease is lost.
+func (c *SecretController) Run(ctx context.Context, workers int) error {
 	defer runtime.HandleCrash()
 	defer c.workqueue.ShutDown()
 
+	ctx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+	defer cancel()
+
 	klog.Info("Starting Secret Rotation Controller")
 
+	c.startMetricsServer()
+
 	klog.Info("Starting informer...")
-	go c.informer.Run(c.stopCh)
+	go c.informer.Run(ctx.Done())
+
+	syncFuncs := []cache.InformerSynced{c.informer.HasSynced}
 
-	klog.Info("Waiting for informer cache to sync...")
-	if !cache.WaitForCacheSync(c.stopCh, c.informer.HasSynced) {
+	if c.policyInformer != nil {
+		klog.Info("Starting SecretRotationPolicy informer...")
+		go c.policyInformer.Run(ctx.Done())
+		syncFuncs = append(syncFuncs, c.policyInformer.HasSynced)
+	}
+
+	klog.Info("Waiting for informer cache(s) to sync...")
+	if !cache.WaitForCacheSync(ctx.Done(), syncFuncs...) {
 		return fmt.Errorf("failed to sync informer cache")
 	}
 	klog.Info("Cache synced successfully")
 
 	klog.Infof("Starting %d worker(s)...", workers)
 	for i := 0; i < workers; i++ {
-		go wait.Until(c.runWorker, time.Second, c.stopCh)
+		go wait.Until(c.runWorker, time.Second, ctx.Done())
 	}
 
 	klog.Info("Workers started. Watching for Secret changes...")
@@ -105,17 +172,57 @@ func (c *SecretController) Run(workers int) error {
 	fmt.Println("Or:  kubectl edit secret <secret-name>")
 	fmt.Println("Press Ctrl+C to stop")
 
-	<-c.stopCh
+	<-ctx.Done()
 	klog.Info("Stopping workers...")
 
 	return nil
 }
 
-// Stop gracefully shuts down the controller and event broadcaster
+// Stop gracefully shuts down the controller and event broadcaster. It is safe to
+// call even if Run's context was already cancelled (e.g. by losing the leader
+// election lease) — cancelling an already-done context is a no-op.
 func (c *SecretController) Stop() {
 	klog.Info("Shutting down controller...")
+	if c.httpServer != nil {
+		if err := c.httpServer.Shutdown(context.TODO()); err != nil {
+			klog.Errorf("Error shutting down metrics server: %v", err)
+		}
+	}
 	c.broadcaster.Shutdown()
-	close(c.stopCh)
+	if c.cancel != nil {
+		c.cancel()
+	}
+}
+
+// startMetricsServer serves /metrics, /healthz, and /readyz on metricsAddr in the
+// background. /readyz reflects informer.HasSynced so load balancers and rolling
+// upgrades don't route traffic before the cache is warm.
+func (c *SecretController) startMetricsServer() {
+	if c.metricsAddr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		if !c.informer.HasSynced() || (c.policyInformer != nil && !c.policyInformer.HasSynced()) {
+			http.Error(w, "informer cache not synced", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c.httpServer = &http.Server{Addr: c.metricsAddr, Handler: mux}
+
+	go func() {
+		klog.Infof("Serving metrics and health checks on %s", c.metricsAddr)
+		if err := c.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			klog.Errorf("Metrics server error: %v", err)
+		}
+	}()
 }
 
 // runWorker loops, processing items from the workqueue until shutdown
@@ -124,25 +231,64 @@ func (c *SecretController) runWorker() {
 	}
 }
 
-// processNextWorkItem pulls one key from the queue and reconciles it. Returns false on shutdown
+// processNextWorkItem pulls one request from the queue and reconciles it, unless a
+// more recent enqueue for the same key has since superseded it. Returns false on shutdown
 func (c *SecretController) processNextWorkItem() bool {
-	key, shutdown := c.workqueue.Get()
+	req, shutdown := c.workqueue.Get()
 	if shutdown {
 		return false
 	}
-	defer c.workqueue.Done(key)
+	defer c.workqueue.Done(req)
+
+	if !c.isCurrentGeneration(req) {
+		klog.V(2).Infof("Dropping stale reconcile for %s (generation %d superseded)", req.Key, req.Generation)
+		c.workqueue.Forget(req)
+		return true
+	}
 
-	err := c.reconcile(key)
+	err := c.reconcile(req.Key)
 	if err != nil {
-		c.workqueue.AddRateLimited(key)
-		klog.Errorf("Error reconciling %s (will retry): %v", key, err)
+		c.workqueue.AddRateLimited(req)
+		klog.Errorf("Error reconciling %s (will retry): %v", req.Key, err)
 		return true
 	}
 
-	c.workqueue.Forget(key)
+	c.workqueue.Forget(req)
 	return true
 }
 
+// enqueue bumps key's generation and adds it to the workqueue for immediate processing.
+func (c *SecretController) enqueue(key string) {
+	c.workqueue.Add(reconcileRequest{Key: key, Generation: c.nextGeneration(key)})
+}
+
+// scheduleAfter bumps key's generation and schedules a delayed reconcile. Any
+// older-generation entry already sitting in the delaying queue for this key becomes
+// stale and is dropped by processNextWorkItem when it eventually fires.
+func (c *SecretController) scheduleAfter(key string, delay time.Duration) {
+	c.workqueue.AddAfter(reconcileRequest{Key: key, Generation: c.nextGeneration(key)}, delay)
+}
+
+// invalidate bumps key's generation without scheduling new work, so any
+// already-pending delayed entry (e.g. from a since-deleted Secret) is dropped as
+// stale instead of triggering a reconcile.
+func (c *SecretController) invalidate(key string) {
+	c.nextGeneration(key)
+}
+
+func (c *SecretController) nextGeneration(key string) uint64 {
+	c.genMu.Lock()
+	defer c.genMu.Unlock()
+	c.generation[key]++
+	return c.generation[key]
+}
+
+func (c *SecretController) isCurrentGeneration(req reconcileRequest) bool {
+	c.genMu.Lock()
+	defer c.genMu.Unlock()
+	return c.generation[req.Key] == req.Generation
+}
+
 // reconcile fetches a Secret from the cache, checks its expiration, and emits Kubernetes Events
 func (c *SecretController) reconcile(key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
@@ -166,21 +312,152 @@ func (c *SecretController) reconcile(key string) error {
 		return fmt.Errorf("unexpected object type in cache: %T", obj)
 	}
 
-	info, hasExpiration := parseSecretInfo(secret)
-	if !hasExpiration {
+	policy := effectivePolicyFor(c.policyInformer, secret)
+
+	info, hasExpiration := resolveSecretInfo(secret, policy)
+	scheduleDue, hasSchedule := policyRotationDue(policy, time.Now())
+
+	if !hasExpiration && !scheduleDue {
 		return nil
 	}
 
-	klog.Infof("Reconciling %s/%s | expires: %s | days: %d",
-		namespace, name,
-		info.ExpiresAt.Format("2006-01-02"),
-		info.DaysUntilExp)
+	if hasExpiration {
+		klog.Infof("Reconciling %s/%s | expires: %s | days: %d",
+			namespace, name,
+			info.ExpiresAt.Format("2006-01-02"),
+			info.DaysUntilExp)
+
+		c.emitExpirationEvent(secret, info)
+		c.updateMetrics(secret, info)
+	}
 
-	c.emitExpirationEvent(secret, info)
+	warnThresholdDays := int(info.WarnBefore.Hours() / 24)
+	shouldRotate := scheduleDue || (hasExpiration && info.DaysUntilExp <= warnThresholdDays)
+
+	if shouldRotate {
+		rotated, err := c.rotateSecret(secret, info, policy)
+		if err != nil {
+			return fmt.Errorf("rotating secret %s: %w", key, err)
+		}
+		if rotated {
+			// Don't re-enqueue here: the informer's local cache hasn't necessarily
+			// observed the Update rotateSecret just issued, so an immediate reconcile
+			// would read the stale pre-rotation Secret and rotate it again.
+			// handleUpdate re-enqueues once the watch delivers the rotated version.
+			return nil
+		}
+		// No rotator resolved (missing or unknown AnnotationRotator/policy.spec.rotator);
+		// fall through to schedule the next boundary/cron check so a later fix to the
+		// rotator config is still picked up without external intervention.
+	}
+
+	delay, ok := time.Duration(0), false
+	if hasExpiration {
+		delay, ok = nextBoundary(info, time.Now())
+	}
+	if hasSchedule {
+		// Always computed fresh from now, not from the scheduleDue check above: that
+		// one is anchored on LastRotationTime, which doesn't move if rotation didn't
+		// actually happen (e.g. shouldRotate fell through with no resolvable rotator).
+		// Reusing it here would reschedule against an already-passed fire time and
+		// spin the worker in a tight retry loop instead of waiting for the next one.
+		if next, ok2 := nextCronFire(policy, time.Now()); ok2 {
+			if scheduleDelay := time.Until(next); !ok || scheduleDelay < delay {
+				delay, ok = scheduleDelay, true
+			}
+		}
+	}
+	if ok {
+		c.scheduleAfter(key, withJitter(delay))
+	}
 
 	return nil
 }
 
+// nextBoundary returns how long until secret's expiration status would next change —
+// crossing into the warn-soon window, reaching ExpiresAt, or into expired — so
+// reconcile can schedule itself instead of relying on a periodic resync. Expired here
+// matches emitExpirationEvent's DaysUntilExp < 0, which (days being truncated) starts a
+// full day after ExpiresAt. Returns (0, false) once that point has passed, since
+// there's no further boundary to detect.
+func nextBoundary(info SecretInfo, now time.Time) (time.Duration, bool) {
+	warnAt := info.ExpiresAt.Add(-info.WarnBefore)
+	expiredAt := info.ExpiresAt.Add(24 * time.Hour)
+
+	if now.Before(warnAt) {
+		return warnAt.Sub(now), true
+	}
+	if now.Before(info.ExpiresAt) {
+		return info.ExpiresAt.Sub(now), true
+	}
+	if now.Before(expiredAt) {
+		return expiredAt.Sub(now), true
+	}
+	return 0, false
+}
+
+// withJitter randomizes d by up to ±10%, so many Secrets sharing an expiry date
+// don't all re-enqueue in the same instant.
+func withJitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	jitter := (rand.Float64()*0.2 - 0.1) * float64(d)
+	return d + time.Duration(jitter)
+}
+
+// rotateSecret looks up the effective Rotator for secret (its own AnnotationRotator
+// annotation, or policy's Rotator field) and, if one is registered, asks it to
+// produce fresh data and a new expiration, then patches the Secret and emits a
+// SecretRotated event. The returned bool reports whether a rotation actually
+// happened; callers should still reschedule the next boundary/cron check when it's
+// false, since a misconfigured rotator can be fixed later.
+func (c *SecretController) rotateSecret(secret *corev1.Secret, info SecretInfo, policy *policyv1alpha1.SecretRotationPolicy) (bool, error) {
+	name, hasRotator := effectiveRotator(secret, policy)
+	if !hasRotator {
+		klog.Warningf("Secret %s/%s is due for rotation but has no rotator configured (no AnnotationRotator and no matching policy.spec.rotator)",
+			secret.Namespace, secret.Name)
+		return false, nil
+	}
+
+	rotator, ok := lookupRotator(name)
+	if !ok {
+		klog.Warningf("Secret %s/%s references unknown rotator %q", secret.Namespace, secret.Name, name)
+		return false, nil
+	}
+
+	ctx := context.TODO()
+	data, expiresAt, err := rotator.Rotate(ctx, secret)
+	if err != nil {
+		return false, fmt.Errorf("rotator %q failed: %w", name, err)
+	}
+
+	updated := secret.DeepCopy()
+	if updated.Data == nil {
+		updated.Data = map[string][]byte{}
+	}
+	for k, v := range data {
+		updated.Data[k] = v
+	}
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	updated.Annotations[AnnotationExpiresAt] = expiresAt.Format("2006-01-02")
+
+	if _, err := c.clientset.CoreV1().Secrets(secret.Namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("updating rotated secret: %w", err)
+	}
+
+	klog.Infof("Rotated %s/%s via %q, new expiry %s",
+		secret.Namespace, secret.Name, name, expiresAt.Format("2006-01-02"))
+	c.recorder.Eventf(secret, corev1.EventTypeNormal, "SecretRotated",
+		"Secret rotated via %q provider, new expiry %s", name, expiresAt.Format("2006-01-02"))
+
+	recordRotation(c.policyClient, policy)
+
+	return true, nil
+}
+
 // emitExpirationEvent creates a Kubernetes Event on the Secret based on its expiration status
 func (c *SecretController) emitExpirationEvent(secret *corev1.Secret, info SecretInfo) {
 	warnThresholdDays := int(info.WarnBefore.Hours() / 24)
@@ -205,7 +482,44 @@ func (c *SecretController) emitExpirationEvent(secret *corev1.Secret, info Secre
 	}
 }
 
-// handleAdd enqueues newly created Secrets that have expiration annotations
+// updateMetrics refreshes the Prometheus gauges/counters for a reconciled Secret
+func (c *SecretController) updateMetrics(secret *corev1.Secret, info SecretInfo) {
+	daysUntilExpirationGauge.WithLabelValues(secret.Namespace, secret.Name).Set(float64(info.DaysUntilExp))
+	if info.DaysUntilExp < 0 {
+		expiredTotalCounter.Inc()
+	}
+	trackedSecretsGauge.Set(float64(c.countTrackedSecrets()))
+}
+
+// countTrackedSecrets returns how many Secrets in the informer's store carry an
+// expiration annotation.
+func (c *SecretController) countTrackedSecrets() int {
+	count := 0
+	for _, obj := range c.informer.GetStore().List() {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			continue
+		}
+		if _, hasExpiration := parseSecretInfo(secret); hasExpiration {
+			count++
+		}
+	}
+	return count
+}
+
+// isManaged reports whether secret should be tracked by the controller: either it (or
+// an effective SecretRotationPolicy matching its labels) resolves an expiration, or the
+// policy rotates it on a cron RotationSchedule independent of any expiration.
+func (c *SecretController) isManaged(secret *corev1.Secret) bool {
+	policy := effectivePolicyFor(c.policyInformer, secret)
+	if _, hasExpiration := resolveSecretInfo(secret, policy); hasExpiration {
+		return true
+	}
+	return policy != nil && policy.Spec.RotationSchedule != ""
+}
+
+// handleAdd enqueues newly created Secrets that are managed by their own annotations
+// or an effective SecretRotationPolicy
 func (c *SecretController) handleAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
@@ -218,15 +532,17 @@ func (c *SecretController) handleAdd(obj interface{}) {
 		return
 	}
 
-	if _, hasExpiration := parseSecretInfo(secret); !hasExpiration {
+	if !c.isManaged(secret) {
 		return
 	}
 
 	klog.V(2).Infof("ADD event: %s", key)
-	c.workqueue.Add(key)
+	c.enqueue(key)
 }
 
-// handleUpdate enqueues Secrets when expiration annotations are added, changed, or removed
+// handleUpdate enqueues Secrets when they start being managed, stop being managed, or
+// were already managed (their own annotations or the matching policy may have changed
+// what they resolve to)
 func (c *SecretController) handleUpdate(oldObj, newObj interface{}) {
 	oldSecret, ok := oldObj.(*corev1.Secret)
 	if !ok {
@@ -249,12 +565,9 @@ func (c *SecretController) handleUpdate(oldObj, newObj interface{}) {
 		return
 	}
 
-	_, oldHasExp := parseSecretInfo(oldSecret)
-	_, newHasExp := parseSecretInfo(newSecret)
-
-	if oldHasExp || newHasExp {
+	if c.isManaged(oldSecret) || c.isManaged(newSecret) {
 		klog.V(2).Infof("UPDATE event: %s", key)
-		c.workqueue.Add(key)
+		c.enqueue(key)
 	}
 }
 
@@ -274,7 +587,7 @@ func (c *SecretController) handleDelete(obj interface{}) {
 		}
 	}
 
-	if _, hasExpiration := parseSecretInfo(secret); !hasExpiration {
+	if !c.isManaged(secret) {
 		return
 	}
 
@@ -284,9 +597,53 @@ func (c *SecretController) handleDelete(obj interface{}) {
 		return
 	}
 
+	daysUntilExpirationGauge.DeleteLabelValues(secret.Namespace, secret.Name)
+	trackedSecretsGauge.Set(float64(c.countTrackedSecrets()))
+
+	// Drop any delayed reconcile already scheduled for this key via scheduleAfter.
+	c.invalidate(key)
+
 	klog.Infof("DELETE: %s (was tracking expiration)", key)
 }
 
+// handlePolicyChange re-syncs a SecretRotationPolicy's status and enqueues every
+// Secret its selector matches, so Secrets with no expiration annotation of their own
+// (only covered by the policy) get reconciled when the policy appears or changes.
+func (c *SecretController) handlePolicyChange(obj interface{}) {
+	policy, ok := obj.(*policyv1alpha1.SecretRotationPolicy)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Unexpected type in handlePolicyChange: %T", obj)
+			return
+		}
+		policy, ok = tombstone.Obj.(*policyv1alpha1.SecretRotationPolicy)
+		if !ok {
+			klog.Errorf("Tombstone contained unexpected type: %T", tombstone.Obj)
+			return
+		}
+	}
+
+	klog.V(2).Infof("SecretRotationPolicy change: %s", policy.Name)
+	syncPolicyStatus(c.policyClient, c.informer, policy)
+
+	selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.Selector)
+	if err != nil {
+		klog.Errorf("SecretRotationPolicy %s has invalid selector: %v", policy.Name, err)
+		return
+	}
+
+	for _, obj := range c.informer.GetStore().List() {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok || !selector.Matches(labels.Set(secret.Labels)) {
+			continue
+		}
+		if key, err := cache.MetaNamespaceKeyFunc(secret); err == nil {
+			c.enqueue(key)
+		}
+	}
+}
+
 // Helpers
 
 // parseSecretInfo extracts expiration information from a Secret's annotations
@@ -303,7 +660,7 @@ func parseSecretInfo(secret *corev1.Secret) (SecretInfo, bool) {
 
 	warnBefore := 7 * 24 * time.Hour
 	if warnStr, hasWarn := secret.Annotations[AnnotationWarnBefore]; hasWarn {
-		if parsed, err := parseDuration(warnStr); err == nil {
+		if parsed, err := timeutil.ParseDuration(warnStr); err == nil {
 			warnBefore = parsed
 		}
 	}
@@ -319,16 +676,3 @@ func parseSecretInfo(secret *corev1.Secret) (SecretInfo, bool) {
 		DaysUntilExp: daysUntil,
 	}, true
 }
-
-// parseDuration extends time.ParseDuration with support for "d" (days)
-func parseDuration(s string) (time.Duration, error) {
-	if len(s) > 1 && s[len(s)-1] == 'd' {
-		daysStr := strings.TrimSuffix(s, "d")
-		days, err := strconv.Atoi(daysStr)
-		if err != nil {
-			return 0, fmt.Errorf("invalid days format: %w", err)
-		}
-		return time.Duration(days) * 24 * time.Hour, nil
-	}
-	return time.ParseDuration(s)
-}
@@ -0,0 +1,128 @@
+// Package timeutil holds small duration-parsing helpers shared across the
+// secret-operator binary and controller packages.
+package timeutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	hoursPerDay  = 24
+	daysPerWeek  = 7
+	daysPerMonth = 30
+	daysPerYear  = 365
+)
+
+// ParseDuration parses a duration from one of three formats: a Go duration string
+// (anything time.ParseDuration accepts, e.g. "90m"), the legacy "<n>d" day count
+// (e.g. "7d"), or an ISO 8601 period (e.g. "P1Y2M3D", "PT12H", "P2W"). The latter is
+// what cert/PKI tooling tends to emit for warn-before style configuration.
+//
+// ISO 8601 year and month components are approximated as 365 and 30 days
+// respectively, since a period isn't anchored to a calendar date and so has no exact
+// length; week, day, hour, minute, and second components are exact.
+func ParseDuration(s string) (time.Duration, error) {
+	if strings.HasPrefix(s, "P") {
+		return parseISO8601Duration(s)
+	}
+
+	if len(s) > 1 && s[len(s)-1] == 'd' {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid days format: %w", err)
+		}
+		if days < 0 {
+			return 0, fmt.Errorf("invalid days format: %q is negative", s)
+		}
+		return time.Duration(days) * hoursPerDay * time.Hour, nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+// parseISO8601Duration parses an ISO 8601 period: "P" followed by an optional date
+// part (Y/M/W/D designators) and an optional "T"-introduced time part (H/M/S
+// designators). The two parts use the same letters for different units (date "M" is
+// months, time "M" is minutes); which side of "T" a component falls on is what
+// disambiguates them, exactly as the standard specifies.
+func parseISO8601Duration(s string) (time.Duration, error) {
+	if len(s) < 2 || s[0] != 'P' {
+		return 0, fmt.Errorf("invalid ISO 8601 duration: %q", s)
+	}
+
+	datePart, timePart, hasTime := strings.Cut(s[1:], "T")
+	if hasTime && timePart == "" {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q: empty time component after T", s)
+	}
+	if datePart == "" && !hasTime {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q: no components", s)
+	}
+
+	total, err := parseISO8601Components(datePart, map[byte]time.Duration{
+		'Y': daysPerYear * hoursPerDay * time.Hour,
+		'M': daysPerMonth * hoursPerDay * time.Hour,
+		'W': daysPerWeek * hoursPerDay * time.Hour,
+		'D': hoursPerDay * time.Hour,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+	}
+
+	if hasTime {
+		timeTotal, err := parseISO8601Components(timePart, map[byte]time.Duration{
+			'H': time.Hour,
+			'M': time.Minute,
+			'S': time.Second,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+		}
+		total += timeTotal
+	}
+
+	return total, nil
+}
+
+// parseISO8601Components parses a sequence of "<non-negative int><designator>"
+// pairs, where designator is a key of units, each appearing at most once.
+func parseISO8601Components(s string, units map[byte]time.Duration) (time.Duration, error) {
+	var total time.Duration
+	seen := make(map[byte]bool)
+
+	for len(s) > 0 {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			return 0, fmt.Errorf("expected a number, got %q", s)
+		}
+
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q: %w", s[:i], err)
+		}
+
+		if i >= len(s) {
+			return 0, fmt.Errorf("number %q has no unit designator", s[:i])
+		}
+		designator := s[i]
+
+		unitDuration, ok := units[designator]
+		if !ok {
+			return 0, fmt.Errorf("unexpected designator %q", string(designator))
+		}
+		if seen[designator] {
+			return 0, fmt.Errorf("duplicate designator %q", string(designator))
+		}
+		seen[designator] = true
+
+		total += time.Duration(n) * unitDuration
+		s = s[i+1:]
+	}
+
+	return total, nil
+}
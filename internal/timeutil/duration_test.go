@@ -0,0 +1,49 @@
+package timeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "go duration", input: "90m", want: 90 * time.Minute},
+		{name: "legacy days suffix", input: "7d", want: 7 * 24 * time.Hour},
+		{name: "iso8601 year", input: "P1Y", want: daysPerYear * hoursPerDay * time.Hour},
+		{name: "iso8601 week and day", input: "P2W3D", want: 2*daysPerWeek*hoursPerDay*time.Hour + 3*hoursPerDay*time.Hour},
+		{name: "iso8601 time only", input: "PT12H", want: 12 * time.Hour},
+		{name: "iso8601 date and time", input: "P1DT6H", want: hoursPerDay*time.Hour + 6*time.Hour},
+		{name: "iso8601 full", input: "P1Y2M3DT4H5M6S", want: daysPerYear*hoursPerDay*time.Hour + 2*daysPerMonth*hoursPerDay*time.Hour + 3*hoursPerDay*time.Hour + 4*time.Hour + 5*time.Minute + 6*time.Second},
+		{name: "malformed missing P", input: "1Y", wantErr: true},
+		{name: "malformed empty period", input: "P", wantErr: true},
+		{name: "malformed empty time part", input: "PT", wantErr: true},
+		{name: "malformed unknown designator", input: "P1X", wantErr: true},
+		{name: "malformed duplicate designator", input: "P1D2D", wantErr: true},
+		{name: "malformed negative component", input: "P-1D", wantErr: true},
+		{name: "malformed negative legacy days", input: "-1d", wantErr: true},
+		{name: "malformed plain number", input: "5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDuration(%q) = %v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDuration(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}